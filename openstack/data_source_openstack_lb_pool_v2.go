@@ -0,0 +1,178 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+func dataSourcePoolV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePoolV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"lb_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"persistence": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"cookie_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"healthmonitor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePoolV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := pools.ListOpts{
+		ID:             d.Get("pool_id").(string),
+		Name:           d.Get("name").(string),
+		ProjectID:      d.Get("project_id").(string),
+		LoadbalancerID: d.Get("loadbalancer_id").(string),
+		ListenerID:     d.Get("listener_id").(string),
+	}
+
+	allPages, err := pools.List(lbClient, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to list openstack_lb_pool_v2: %s", err)
+	}
+
+	allPools, err := pools.ExtractPools(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_lb_pool_v2: %s", err)
+	}
+
+	if len(allPools) < 1 {
+		return diag.Errorf("Your openstack_lb_pool_v2 query returned no results")
+	}
+
+	if len(allPools) > 1 {
+		return diag.Errorf("Your openstack_lb_pool_v2 query returned more than one result")
+	}
+
+	pool := allPools[0]
+
+	d.SetId(pool.ID)
+	d.Set("pool_id", pool.ID)
+	d.Set("name", pool.Name)
+	d.Set("description", pool.Description)
+	d.Set("project_id", pool.ProjectID)
+	d.Set("protocol", pool.Protocol)
+	d.Set("lb_method", pool.LBMethod)
+	d.Set("persistence", flattenLBPoolPersistenceV2(pool.Persistence))
+	d.Set("admin_state_up", pool.AdminStateUp)
+	d.Set("healthmonitor_id", pool.MonitorID)
+	d.Set("operating_status", pool.OperatingStatus)
+	d.Set("provisioning_status", pool.ProvisioningStatus)
+	d.Set("region", GetRegion(d, config))
+
+	if len(pool.Listeners) > 0 {
+		d.Set("listener_id", pool.Listeners[0].ID)
+	}
+	if len(pool.Loadbalancers) > 0 {
+		d.Set("loadbalancer_id", pool.Loadbalancers[0].ID)
+	}
+
+	members := make([]string, len(pool.Members))
+	for i, m := range pool.Members {
+		members[i] = m.ID
+	}
+	d.Set("members", members)
+
+	return nil
+}
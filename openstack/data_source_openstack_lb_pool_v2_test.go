@@ -0,0 +1,59 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBV2PoolDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckLB(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV2PoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2PoolDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_pool_v2.pool_1", "id",
+						"openstack_lb_pool_v2.pool_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_pool_v2.pool_1", "name",
+						"openstack_lb_pool_v2.pool_1", "name"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_pool_v2.pool_1", "loadbalancer_id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_pool_v2.pool_1", "operating_status"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_pool_v2.pool_1", "provisioning_status"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLBV2PoolDataSourceConfig_basic = testAccLBV2PoolConfig_base + `
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  protocol        = "HTTP"
+  protocol_port   = 80
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name        = "tf_test_lb_pool_datasource"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = openstack_lb_listener_v2.listener_1.id
+}
+
+data "openstack_lb_pool_v2" "pool_1" {
+  name            = openstack_lb_pool_v2.pool_1.name
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+`
@@ -0,0 +1,115 @@
+package openstack
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+func expandLBPoolPersistenceV2(raw []interface{}) pools.SessionPersistence {
+	if len(raw) == 0 || raw[0] == nil {
+		return pools.SessionPersistence{}
+	}
+
+	pV := raw[0].(map[string]interface{})
+
+	return pools.SessionPersistence{
+		Type:       pV["type"].(string),
+		CookieName: pV["cookie_name"].(string),
+	}
+}
+
+// poolUpdateOptsClearPersistenceV2 wraps pools.UpdateOpts to force
+// "session_persistence": null onto the request body. gophercloud's
+// UpdateOpts omits the Persistence field entirely when it is nil, which
+// is indistinguishable from "leave it alone" to Octavia.
+type poolUpdateOptsClearPersistenceV2 struct {
+	pools.UpdateOpts
+}
+
+func (opts poolUpdateOptsClearPersistenceV2) ToPoolUpdateMap() (map[string]interface{}, error) {
+	b, err := opts.UpdateOpts.ToPoolUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	pool, ok := b["pool"].(map[string]interface{})
+	if !ok {
+		pool = map[string]interface{}{}
+		b["pool"] = pool
+	}
+	pool["session_persistence"] = nil
+
+	return b, nil
+}
+
+func expandLBPoolMembersV2(raw *schema.Set) []pools.BatchUpdateMemberOpts {
+	members := make([]pools.BatchUpdateMemberOpts, raw.Len())
+	for i, v := range raw.List() {
+		m := v.(map[string]interface{})
+
+		adminStateUp := m["admin_state_up"].(bool)
+		weight := m["weight"].(int)
+		backup := m["backup"].(bool)
+
+		opts := pools.BatchUpdateMemberOpts{
+			Address:      m["address"].(string),
+			ProtocolPort: m["protocol_port"].(int),
+			SubnetID:     m["subnet_id"].(string),
+			Name:         m["name"].(string),
+			AdminStateUp: &adminStateUp,
+			Weight:       &weight,
+			Backup:       &backup,
+			Tags:         expandToStringSlice(m["tags"].(*schema.Set).List()),
+		}
+
+		if v := m["monitor_address"].(string); v != "" {
+			opts.MonitorAddress = &v
+		}
+		if v := m["monitor_port"].(int); v != 0 {
+			opts.MonitorPort = &v
+		}
+
+		members[i] = opts
+	}
+
+	return members
+}
+
+func flattenLBPoolMembersV2(members []pools.Member) []map[string]interface{} {
+	res := make([]map[string]interface{}, len(members))
+	for i, m := range members {
+		res[i] = map[string]interface{}{
+			"address":         m.Address,
+			"protocol_port":   m.ProtocolPort,
+			"subnet_id":       m.SubnetID,
+			"name":            m.Name,
+			"weight":          m.Weight,
+			"backup":          m.Backup,
+			"monitor_address": m.MonitorAddress,
+			"monitor_port":    m.MonitorPort,
+			"admin_state_up":  m.AdminStateUp,
+			"tags":            m.Tags,
+		}
+	}
+
+	return res
+}
+
+func expandLBPoolTLSVersionsV2(raw []interface{}) []pools.TLSVersion {
+	versions := make([]pools.TLSVersion, len(raw))
+	for i, v := range raw {
+		versions[i] = pools.TLSVersion(v.(string))
+	}
+
+	return versions
+}
+
+func flattenLBPoolTLSVersionsV2(versions []pools.TLSVersion) []string {
+	res := make([]string, len(versions))
+	for i, v := range versions {
+		res[i] = string(v)
+	}
+
+	return res
+}
@@ -0,0 +1,27 @@
+package openstack
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provider returns a schema.Provider for OpenStack.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"openstack_lb_pool_v2": resourcePoolV2(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"openstack_lb_pool_v2": dataSourcePoolV2(),
+		},
+
+		ConfigureContextFunc: configureProvider,
+	}
+}
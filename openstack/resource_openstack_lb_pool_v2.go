@@ -25,6 +25,8 @@ func resourcePoolV2() *schema.Resource {
 			StateContext: resourcePoolV2Import,
 		},
 
+		CustomizeDiff: resourcePoolV2CustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -93,7 +95,6 @@ func resourcePoolV2() *schema.Resource {
 			"persistence": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
@@ -101,7 +102,6 @@ func resourcePoolV2() *schema.Resource {
 						"type": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 							ValidateFunc: validation.StringInSlice([]string{
 								"SOURCE_IP", "HTTP_COOKIE", "APP_COOKIE",
 							}, false),
@@ -110,7 +110,6 @@ func resourcePoolV2() *schema.Resource {
 						"cookie_name": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -121,10 +120,181 @@ func resourcePoolV2() *schema.Resource {
 				Default:  true,
 				Optional: true,
 			},
+
+			"tls_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"tls_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ca_tls_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"crl_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tls_ciphers": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tls_versions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"SSLv3", "TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3",
+					}, false),
+				},
+			},
+
+			"alpn_protocols": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"http/1.1", "http/1.0", "h2",
+					}, false),
+				},
+			},
+
+			// Manages pool membership in bulk via Octavia's batch member
+			// update endpoint. This is mutually exclusive with managing
+			// members one-by-one via openstack_lb_member_v2 resources
+			// pointed at the same pool; mixing the two will cause them to
+			// fight over membership on every apply.
+			"member": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      resourcePoolMemberV2Hash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"protocol_port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"weight": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+
+						"backup": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"monitor_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"monitor_port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"tags": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
+func resourcePoolMemberV2Hash(v interface{}) int {
+	m := v.(map[string]interface{})
+
+	return schema.HashString(fmt.Sprintf("%s-%d-%s",
+		m["address"].(string), m["protocol_port"].(int), m["subnet_id"].(string)))
+}
+
+func resourcePoolV2CustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOk("persistence"); ok {
+		pV := (v.([]interface{}))[0].(map[string]interface{})
+		persistenceType := pV["type"].(string)
+		cookieName := pV["cookie_name"].(string)
+
+		if persistenceType == "APP_COOKIE" && cookieName == "" {
+			return fmt.Errorf(
+				"persistence cookie_name needs to be set if using 'APP_COOKIE' persistence type")
+		}
+		if persistenceType != "APP_COOKIE" && cookieName != "" {
+			return fmt.Errorf(
+				"persistence cookie_name can only be set if using 'APP_COOKIE' persistence type")
+		}
+	}
+
+	if diff.Get("tls_enabled").(bool) && diff.Get("tls_container_ref").(string) == "" {
+		return fmt.Errorf(
+			"tls_container_ref is required when tls_enabled is true")
+	}
+
+	return nil
+}
+
 func resourcePoolV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	lbClient, err := chooseLBV2Client(d, config)
@@ -135,27 +305,7 @@ func resourcePoolV2Create(ctx context.Context, d *schema.ResourceData, meta inte
 	adminStateUp := d.Get("admin_state_up").(bool)
 	lbID := d.Get("loadbalancer_id").(string)
 	listenerID := d.Get("listener_id").(string)
-	var persistence pools.SessionPersistence
-	if p, ok := d.GetOk("persistence"); ok {
-		pV := (p.([]interface{}))[0].(map[string]interface{})
-
-		persistence = pools.SessionPersistence{
-			Type: pV["type"].(string),
-		}
-
-		if persistence.Type == "APP_COOKIE" {
-			if pV["cookie_name"].(string) == "" {
-				return diag.Errorf(
-					"Persistence cookie_name needs to be set if using 'APP_COOKIE' persistence type")
-			}
-			persistence.CookieName = pV["cookie_name"].(string)
-		} else {
-			if pV["cookie_name"].(string) != "" {
-				return diag.Errorf(
-					"Persistence cookie_name can only be set if using 'APP_COOKIE' persistence type")
-			}
-		}
-	}
+	persistence := expandLBPoolPersistenceV2(d.Get("persistence").([]interface{}))
 
 	createOpts := pools.CreateOpts{
 		TenantID:       d.Get("tenant_id").(string),
@@ -173,6 +323,25 @@ func resourcePoolV2Create(ctx context.Context, d *schema.ResourceData, meta inte
 		createOpts.Persistence = &persistence
 	}
 
+	// Backend re-encryption is an Octavia-only feature, so it's not relevant
+	// to neutron-lbaas clients.
+	if config.UseOctavia {
+		tlsEnabled := d.Get("tls_enabled").(bool)
+
+		createOpts.TLSEnabled = &tlsEnabled
+		createOpts.TLSContainerRef = d.Get("tls_container_ref").(string)
+		createOpts.CATLSContainerRef = d.Get("ca_tls_container_ref").(string)
+		createOpts.CRLContainerRef = d.Get("crl_container_ref").(string)
+		createOpts.TLSCiphers = d.Get("tls_ciphers").(string)
+		createOpts.TLSVersions = expandLBPoolTLSVersionsV2(d.Get("tls_versions").([]interface{}))
+		createOpts.ALPNProtocols = expandToStringSlice(d.Get("alpn_protocols").([]interface{}))
+
+		// Tags are also an Octavia-only feature.
+		if v, ok := d.GetOk("tags"); ok {
+			createOpts.Tags = expandToStringSlice(v.(*schema.Set).List())
+		}
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
 	timeout := d.Timeout(schema.TimeoutCreate)
@@ -220,10 +389,33 @@ func resourcePoolV2Create(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.SetId(pool.ID)
 
+	if v, ok := d.GetOk("member"); ok {
+		batchUpdateOpts := expandLBPoolMembersV2(v.(*schema.Set))
+
+		log.Printf("[DEBUG] Attempting to batch update members of pool %s", pool.ID)
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			err = pools.BatchUpdateMembers(lbClient, pool.ID, batchUpdateOpts).ExtractErr()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return diag.Errorf("Error batch updating members of pool %s: %s", pool.ID, err)
+		}
+
+		err = waitForLBV2Pool(ctx, lbClient, pool, "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourcePoolV2Read(ctx, d, meta)
 }
 
 func resourcePoolV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	config := meta.(*Config)
 	lbClient, err := chooseLBV2Client(d, config)
 	if err != nil {
@@ -245,8 +437,42 @@ func resourcePoolV2Read(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("name", pool.Name)
 	d.Set("persistence", flattenLBPoolPersistenceV2(pool.Persistence))
 	d.Set("region", GetRegion(d, config))
+	d.Set("provisioning_status", pool.ProvisioningStatus)
+	d.Set("operating_status", pool.OperatingStatus)
+
+	// Backend re-encryption, tags, and created/updated timestamps are
+	// Octavia-only metadata; neutron-lbaas never populates them, so leave
+	// them unset there instead of emitting a bogus zero-time string.
+	if config.UseOctavia {
+		d.Set("tls_enabled", pool.TLSEnabled)
+		d.Set("tls_container_ref", pool.TLSContainerRef)
+		d.Set("ca_tls_container_ref", pool.CATLSContainerRef)
+		d.Set("crl_container_ref", pool.CRLContainerRef)
+		d.Set("tls_ciphers", pool.TLSCiphers)
+		d.Set("tls_versions", flattenLBPoolTLSVersionsV2(pool.TLSVersions))
+		d.Set("alpn_protocols", pool.ALPNProtocols)
+		d.Set("tags", pool.Tags)
+		d.Set("created_at", pool.CreatedAt.Format(time.RFC3339))
+		d.Set("updated_at", pool.UpdatedAt.Format(time.RFC3339))
+	}
 
-	return nil
+	// Only sync members into state when the user has actually opted into
+	// batch member management via the "member" block. Otherwise, pools
+	// whose members are managed out-of-band (standalone
+	// openstack_lb_member_v2 resources, or a pool that predates this
+	// attribute) would show a diff to remove every real member, and
+	// applying that diff would batch-delete them.
+	if _, ok := d.GetOk("member"); ok {
+		d.Set("member", flattenLBPoolMembersV2(pool.Members))
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "openstack_lb_pool_v2 \"member\" block manages pool membership exclusively",
+			Detail:   "Do not also manage members of this pool with standalone openstack_lb_member_v2 resources: the two will fight over membership and the next apply of one will undo the other.",
+		})
+	}
+
+	return diags
 }
 
 func resourcePoolV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -257,6 +483,7 @@ func resourcePoolV2Update(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	var updateOpts pools.UpdateOpts
+	clearPersistence := false
 	if d.HasChange("lb_method") {
 		updateOpts.LBMethod = pools.LBMethod(d.Get("lb_method").(string))
 	}
@@ -272,6 +499,47 @@ func resourcePoolV2Update(ctx context.Context, d *schema.ResourceData, meta inte
 		asu := d.Get("admin_state_up").(bool)
 		updateOpts.AdminStateUp = &asu
 	}
+	if d.HasChange("persistence") {
+		if _, ok := d.GetOk("persistence"); ok {
+			persistence := expandLBPoolPersistenceV2(d.Get("persistence").([]interface{}))
+			updateOpts.Persistence = &persistence
+		} else {
+			// Octavia requires an explicit "session_persistence": null to
+			// clear persistence; pools.UpdateOpts omits a nil Persistence.
+			clearPersistence = true
+		}
+	}
+
+	if config.UseOctavia {
+		if d.HasChange("tls_enabled") || d.HasChange("tls_container_ref") {
+			tlsEnabled := d.Get("tls_enabled").(bool)
+			tlsContainerRef := d.Get("tls_container_ref").(string)
+
+			updateOpts.TLSEnabled = &tlsEnabled
+			updateOpts.TLSContainerRef = &tlsContainerRef
+		}
+		if d.HasChange("ca_tls_container_ref") {
+			caTLSContainerRef := d.Get("ca_tls_container_ref").(string)
+			updateOpts.CATLSContainerRef = &caTLSContainerRef
+		}
+		if d.HasChange("crl_container_ref") {
+			crlContainerRef := d.Get("crl_container_ref").(string)
+			updateOpts.CRLContainerRef = &crlContainerRef
+		}
+		if d.HasChange("tls_ciphers") {
+			tlsCiphers := d.Get("tls_ciphers").(string)
+			updateOpts.TLSCiphers = &tlsCiphers
+		}
+		if d.HasChange("tls_versions") {
+			updateOpts.TLSVersions = expandLBPoolTLSVersionsV2(d.Get("tls_versions").([]interface{}))
+		}
+		if d.HasChange("alpn_protocols") {
+			updateOpts.ALPNProtocols = expandToStringSlice(d.Get("alpn_protocols").([]interface{}))
+		}
+		if d.HasChange("tags") {
+			updateOpts.Tags = expandToStringSlice(d.Get("tags").(*schema.Set).List())
+		}
+	}
 
 	timeout := d.Timeout(schema.TimeoutUpdate)
 
@@ -287,9 +555,14 @@ func resourcePoolV2Update(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
-	log.Printf("[DEBUG] Updating pool %s with options: %#v", d.Id(), updateOpts)
+	var updateOptsBuilder pools.UpdateOptsBuilder = updateOpts
+	if clearPersistence {
+		updateOptsBuilder = poolUpdateOptsClearPersistenceV2{updateOpts}
+	}
+
+	log.Printf("[DEBUG] Updating pool %s with options: %#v", d.Id(), updateOptsBuilder)
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		_, err = pools.Update(lbClient, d.Id(), updateOpts).Extract()
+		_, err = pools.Update(lbClient, d.Id(), updateOptsBuilder).Extract()
 		if err != nil {
 			return checkForRetryableError(err)
 		}
@@ -306,6 +579,27 @@ func resourcePoolV2Update(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("member") {
+		batchUpdateOpts := expandLBPoolMembersV2(d.Get("member").(*schema.Set))
+
+		log.Printf("[DEBUG] Attempting to batch update members of pool %s", d.Id())
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			err = pools.BatchUpdateMembers(lbClient, d.Id(), batchUpdateOpts).ExtractErr()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return diag.Errorf("Error batch updating members of pool %s: %s", d.Id(), err)
+		}
+
+		err = waitForLBV2Pool(ctx, lbClient, pool, "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourcePoolV2Read(ctx, d, meta)
 }
 
@@ -368,5 +662,14 @@ func resourcePoolV2Import(ctx context.Context, d *schema.ResourceData, meta inte
 		return nil, fmt.Errorf("Unable to detect pool's Listener ID or Load Balancer ID")
 	}
 
+	// Populate "member" from the pool's existing members exactly once, on
+	// import, so a batch-managed pool can be imported in one step. This
+	// bypasses the d.GetOk("member") gate in resourcePoolV2Read, which
+	// otherwise keeps this resource from clobbering pools whose members
+	// are managed some other way.
+	if len(pool.Members) > 0 {
+		d.Set("member", flattenLBPoolMembersV2(pool.Members))
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
@@ -0,0 +1,413 @@
+package openstack
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccLBV2Pool_octavia_tlsEnabled(t *testing.T) {
+	var pool pools.Pool
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckLB(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV2PoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2PoolConfig_tlsEnabled,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "tls_enabled", "true"),
+					resource.TestCheckResourceAttrSet("openstack_lb_pool_v2.pool_1", "tls_container_ref"),
+					resource.TestCheckResourceAttrSet("openstack_lb_pool_v2.pool_1", "ca_tls_container_ref"),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "tls_versions.#", "1"),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "tls_versions.0", "TLSv1.2"),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "alpn_protocols.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLBV2Pool_octavia_tlsEnabledRequiresContainerRef(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckLB(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV2PoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLBV2PoolConfig_tlsEnabledNoContainerRef,
+				ExpectError: regexp.MustCompile("tls_container_ref is required when tls_enabled is true"),
+			},
+		},
+	})
+}
+
+func TestAccLBV2Pool_persistenceUpdate(t *testing.T) {
+	var pool1, pool2, pool3 pools.Pool
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckLB(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV2PoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2PoolConfig_persistenceSourceIP,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool1),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "persistence.0.type", "SOURCE_IP"),
+				),
+			},
+			{
+				Config: testAccLBV2PoolConfig_persistenceHTTPCookie,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool2),
+					testAccCheckLBV2PoolNotRecreated(&pool1, &pool2),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "persistence.0.type", "HTTP_COOKIE"),
+				),
+			},
+			{
+				Config: testAccLBV2PoolConfig_persistenceAppCookie,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool3),
+					testAccCheckLBV2PoolNotRecreated(&pool2, &pool3),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "persistence.0.type", "APP_COOKIE"),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "persistence.0.cookie_name", "testCookie"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLBV2Pool_memberBatchUpdate(t *testing.T) {
+	var pool1, pool2 pools.Pool
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckLB(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV2PoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2PoolConfig_members,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool1),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "member.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("openstack_lb_pool_v2.pool_1", "member.*", map[string]string{
+						"address":       "192.168.199.10",
+						"protocol_port": "8080",
+						"weight":        "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("openstack_lb_pool_v2.pool_1", "member.*", map[string]string{
+						"address":       "192.168.199.11",
+						"protocol_port": "8080",
+						"weight":        "1",
+					}),
+				),
+			},
+			{
+				Config: testAccLBV2PoolConfig_membersUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool2),
+					testAccCheckLBV2PoolNotRecreated(&pool1, &pool2),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "member.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("openstack_lb_pool_v2.pool_1", "member.*", map[string]string{
+						"address":       "192.168.199.10",
+						"protocol_port": "8080",
+						"weight":        "10",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("openstack_lb_pool_v2.pool_1", "member.*", map[string]string{
+						"address":       "192.168.199.12",
+						"protocol_port": "8080",
+						"weight":        "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLBV2Pool_tagsAndMetadata(t *testing.T) {
+	var pool1, pool2 pools.Pool
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckLB(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV2PoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2PoolConfig_tags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool1),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr("openstack_lb_pool_v2.pool_1", "tags.*", "tag1"),
+					resource.TestCheckTypeSetElemAttr("openstack_lb_pool_v2.pool_1", "tags.*", "tag2"),
+					resource.TestCheckResourceAttrSet("openstack_lb_pool_v2.pool_1", "provisioning_status"),
+					resource.TestCheckResourceAttrSet("openstack_lb_pool_v2.pool_1", "operating_status"),
+					resource.TestCheckResourceAttrSet("openstack_lb_pool_v2.pool_1", "created_at"),
+					resource.TestCheckResourceAttrSet("openstack_lb_pool_v2.pool_1", "updated_at"),
+				),
+			},
+			{
+				Config: testAccLBV2PoolConfig_tagsUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool2),
+					testAccCheckLBV2PoolNotRecreated(&pool1, &pool2),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr("openstack_lb_pool_v2.pool_1", "tags.*", "tag3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2PoolNotRecreated(before, after *pools.Pool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.ID != after.ID {
+			return fmt.Errorf("openstack_lb_pool_v2 was recreated: before %s, after %s", before.ID, after.ID)
+		}
+		return nil
+	}
+}
+
+const testAccLBV2PoolConfig_base = `
+resource "openstack_networking_network_v2" "network_1" {
+  name           = "tf_test_network_lb_pool"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name       = "tf_test_subnet_lb_pool"
+  network_id = openstack_networking_network_v2.network_1.id
+  cidr       = "192.168.199.0/24"
+  ip_version = 4
+}
+`
+
+const testAccLBV2PoolConfig_tlsEnabled = testAccLBV2PoolConfig_base + `
+resource "openstack_keymanager_secret_v2" "certificate_1" {
+  name                 = "tf_test_lb_pool_cert"
+  payload              = "certificate and private key contents"
+  payload_content_type = "text/plain"
+  secret_type          = "certificate"
+}
+
+resource "openstack_keymanager_secret_v2" "ca_certificate_1" {
+  name                 = "tf_test_lb_pool_ca_cert"
+  payload              = "CA bundle contents"
+  payload_content_type = "text/plain"
+  secret_type          = "certificate"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  protocol        = "HTTPS"
+  protocol_port   = 443
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  protocol             = "HTTPS"
+  lb_method            = "ROUND_ROBIN"
+  listener_id          = openstack_lb_listener_v2.listener_1.id
+  tls_enabled          = true
+  tls_container_ref    = openstack_keymanager_secret_v2.certificate_1.secret_ref
+  ca_tls_container_ref = openstack_keymanager_secret_v2.ca_certificate_1.secret_ref
+  tls_versions         = ["TLSv1.2"]
+  alpn_protocols       = ["http/1.1", "h2"]
+}
+`
+
+const testAccLBV2PoolConfig_tlsEnabledNoContainerRef = testAccLBV2PoolConfig_base + `
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  protocol        = "HTTPS"
+  protocol_port   = 443
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  protocol    = "HTTPS"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = openstack_lb_listener_v2.listener_1.id
+  tls_enabled = true
+}
+`
+
+const testAccLBV2PoolConfig_persistenceSourceIP = testAccLBV2PoolConfig_base + `
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  protocol        = "HTTP"
+  protocol_port   = 80
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = openstack_lb_listener_v2.listener_1.id
+
+  persistence {
+    type = "SOURCE_IP"
+  }
+}
+`
+
+const testAccLBV2PoolConfig_persistenceHTTPCookie = testAccLBV2PoolConfig_base + `
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  protocol        = "HTTP"
+  protocol_port   = 80
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = openstack_lb_listener_v2.listener_1.id
+
+  persistence {
+    type = "HTTP_COOKIE"
+  }
+}
+`
+
+const testAccLBV2PoolConfig_persistenceAppCookie = testAccLBV2PoolConfig_base + `
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  protocol        = "HTTP"
+  protocol_port   = 80
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = openstack_lb_listener_v2.listener_1.id
+
+  persistence {
+    type        = "APP_COOKIE"
+    cookie_name = "testCookie"
+  }
+}
+`
+
+const testAccLBV2PoolConfig_members = testAccLBV2PoolConfig_base + `
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  protocol        = "HTTP"
+  protocol_port   = 80
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = openstack_lb_listener_v2.listener_1.id
+
+  member {
+    address       = "192.168.199.10"
+    protocol_port = 8080
+    subnet_id     = openstack_networking_subnet_v2.subnet_1.id
+  }
+
+  member {
+    address       = "192.168.199.11"
+    protocol_port = 8080
+    subnet_id     = openstack_networking_subnet_v2.subnet_1.id
+  }
+}
+`
+
+const testAccLBV2PoolConfig_tags = testAccLBV2PoolConfig_base + `
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  protocol        = "HTTP"
+  protocol_port   = 80
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = openstack_lb_listener_v2.listener_1.id
+  tags        = ["tag1", "tag2"]
+}
+`
+
+const testAccLBV2PoolConfig_tagsUpdated = testAccLBV2PoolConfig_base + `
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  protocol        = "HTTP"
+  protocol_port   = 80
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = openstack_lb_listener_v2.listener_1.id
+  tags        = ["tag3"]
+}
+`
+
+const testAccLBV2PoolConfig_membersUpdated = testAccLBV2PoolConfig_base + `
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  protocol        = "HTTP"
+  protocol_port   = 80
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = openstack_lb_listener_v2.listener_1.id
+
+  // 192.168.199.11 is removed, 192.168.199.12 is added, and
+  // 192.168.199.10 is reweighted, all via a single batch update.
+  member {
+    address       = "192.168.199.10"
+    protocol_port = 8080
+    subnet_id     = openstack_networking_subnet_v2.subnet_1.id
+    weight        = 10
+  }
+
+  member {
+    address       = "192.168.199.12"
+    protocol_port = 8080
+    subnet_id     = openstack_networking_subnet_v2.subnet_1.id
+  }
+}
+`